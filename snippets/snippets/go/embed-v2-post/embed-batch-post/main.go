@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"time"
+
+	cohere "github.com/cohere-ai/cohere-go/v2"
+	client "github.com/cohere-ai/cohere-go/v2/client"
+)
+
+// maxBatchSize is the largest number of texts the Embed endpoint accepts
+// in a single request.
+const maxBatchSize = 96
+
+func main() {
+	co := client.NewClient(client.WithToken("<<apiKey>>"))
+
+	inputs := make([]string, 10000)
+	for i := range inputs {
+		inputs[i] = "example document text to embed"
+	}
+
+	var allEmbeddings [][]float64
+
+	for start := 0; start < len(inputs); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+
+		resp, err := embedWithBackoff(co, inputs[start:end])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		allEmbeddings = append(allEmbeddings, resp.Embeddings.Float...)
+	}
+
+	log.Printf("embedded %d inputs", len(allEmbeddings))
+}
+
+// embedWithBackoff retries the Embed call with exponential backoff and jitter,
+// up to maxAttempts times. Every error is retried; transient (429/5xx) errors
+// are not told apart from permanent ones.
+func embedWithBackoff(co *client.Client, texts []string) (*cohere.EmbedByTypeResponse, error) {
+	const maxAttempts = 5
+
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		resp, err := co.V2.Embed(
+			context.TODO(),
+			&cohere.V2EmbedRequest{
+				Texts:          texts,
+				Model:          "embed-english-v3.0",
+				InputType:      cohere.EmbedInputTypeSearchDocument,
+				EmbeddingTypes: []cohere.EmbeddingType{cohere.EmbeddingTypeFloat},
+			},
+		)
+		if err == nil {
+			return resp, nil
+		}
+
+		lastErr = err
+		if attempt == maxAttempts-1 {
+			break
+		}
+		backoff := time.Duration(1<<attempt)*time.Second + time.Duration(rand.Intn(1000))*time.Millisecond
+		log.Printf("embed attempt %d failed, retrying in %s: %v", attempt+1, backoff, err)
+		time.Sleep(backoff)
+	}
+
+	return nil, lastErr
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+
+	cohere "github.com/cohere-ai/cohere-go/v2"
+	client "github.com/cohere-ai/cohere-go/v2/client"
+)
+
+// cacheKey hashes the fields that make an embedding reusable, so repeated
+// inputs don't need a second network round trip.
+func cacheKey(model, inputType, text string) string {
+	sum := sha256.Sum256([]byte(model + "|" + inputType + "|" + text))
+	return hex.EncodeToString(sum[:])
+}
+
+func main() {
+	co := client.NewClient(client.WithToken("<<apiKey>>"))
+
+	cacheDir := "./embedding-cache"
+	if err := os.MkdirAll(cacheDir, 0o755); err != nil {
+		log.Fatal(err)
+	}
+
+	text := "hello world"
+	model := "embed-english-v3.0"
+	inputType := string(cohere.EmbedInputTypeSearchDocument)
+
+	key := cacheKey(model, inputType, text)
+	cachePath := cacheDir + "/" + key
+
+	if cached, err := os.ReadFile(cachePath); err == nil {
+		log.Printf("cache hit: %s", cached)
+		return
+	}
+
+	resp, err := co.V2.Embed(
+		context.TODO(),
+		&cohere.V2EmbedRequest{
+			Texts:          []string{text},
+			Model:          model,
+			InputType:      cohere.EmbedInputTypeSearchDocument,
+			EmbeddingTypes: []cohere.EmbeddingType{cohere.EmbeddingTypeFloat},
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(cachePath, []byte(fmt.Sprintf("%+v", resp)), 0o644); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("cache miss, fetched: %+v", resp)
+}
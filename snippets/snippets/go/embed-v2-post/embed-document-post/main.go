@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+
+	cohere "github.com/cohere-ai/cohere-go/v2"
+	client "github.com/cohere-ai/cohere-go/v2/client"
+)
+
+func main() {
+	co := client.NewClient(client.WithToken("<<apiKey>>"))
+
+	path := "./quarterly-report.png"
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	mimeType := mime.TypeByExtension(filepath.Ext(path))
+	dataURI := fmt.Sprintf("data:%s;base64,%s", mimeType, base64.StdEncoding.EncodeToString(data))
+
+	resp, err := co.V2.Embed(
+		context.TODO(),
+		&cohere.V2EmbedRequest{
+			Images:         []string{dataURI},
+			Model:          "embed-english-v3.0",
+			InputType:      cohere.EmbedInputTypeImage,
+			EmbeddingTypes: []cohere.EmbeddingType{cohere.EmbeddingTypeFloat},
+		},
+	)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("%+v", resp)
+}
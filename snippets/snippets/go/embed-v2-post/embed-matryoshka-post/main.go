@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	cohere "github.com/cohere-ai/cohere-go/v2"
+	client "github.com/cohere-ai/cohere-go/v2/client"
+)
+
+func main() {
+	co := client.NewClient(client.WithToken("<<apiKey>>"))
+
+	// embed-v4.0 supports output_dimension, so the server returns a
+	// Matryoshka-truncated prefix of the full embedding directly -
+	// no second round trip needed to shrink it.
+	resp, err := co.V2.Embed(
+		context.TODO(),
+		&cohere.V2EmbedRequest{
+			Texts:           []string{"hello world", "goodbye world"},
+			Model:           "embed-v4.0",
+			InputType:       cohere.EmbedInputTypeSearchDocument,
+			EmbeddingTypes:  []cohere.EmbeddingType{cohere.EmbeddingTypeFloat, cohere.EmbeddingTypeInt8, cohere.EmbeddingTypeBinary},
+			OutputDimension: cohere.Int(256),
+		},
+	)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("float: %+v", resp.Embeddings.Float)
+	log.Printf("int8: %+v", resp.Embeddings.Int8)
+	log.Printf("binary: %+v", resp.Embeddings.Binary)
+}
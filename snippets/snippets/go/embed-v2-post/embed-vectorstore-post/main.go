@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	cohere "github.com/cohere-ai/cohere-go/v2"
+	client "github.com/cohere-ai/cohere-go/v2/client"
+	_ "github.com/lib/pq"
+	"github.com/pgvector/pgvector-go"
+)
+
+func main() {
+	co := client.NewClient(client.WithToken("<<apiKey>>"))
+
+	db, err := sql.Open("postgres", "<<postgresDsn>>")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	resp, err := co.V2.Embed(
+		context.TODO(),
+		&cohere.V2EmbedRequest{
+			Texts:          []string{"example document text to embed"},
+			Model:          "embed-english-v3.0",
+			InputType:      cohere.EmbedInputTypeSearchDocument,
+			EmbeddingTypes: []cohere.EmbeddingType{cohere.EmbeddingTypeFloat},
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, embedding := range resp.Embeddings.Float {
+		_, err := db.ExecContext(
+			context.TODO(),
+			"INSERT INTO docs (embedding) VALUES ($1)",
+			pgvector.NewVector(toFloat32(embedding)),
+		)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	log.Println("indexed embeddings into pgvector")
+}
+
+func toFloat32(in []float64) []float32 {
+	out := make([]float32, len(in))
+	for i, v := range in {
+		out[i] = float32(v)
+	}
+	return out
+}